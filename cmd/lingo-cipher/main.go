@@ -0,0 +1,137 @@
+// Command lingo-cipher searches a word list for letter-cipher equations
+// (sums, products, differences, or quotients) under a given key.
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/spf13/pflag"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+	"github.com/Daniel-42-z/lingo-cipher/pkg/sink"
+)
+
+func main() {
+	var (
+		wordListPath string
+		upperBound   int
+		key          string
+		leading0     bool
+		outputPath   string
+		opFlag       string
+		formatFlag   string
+		workers      int
+		wordListEnc  string
+		terms        int
+	)
+
+	pflag.StringVarP(&wordListPath, "word-list", "w", "words.txt", "Path to word list used")
+	pflag.StringVar(&wordListEnc, "word-list-encoding", "", "Charset the word list is encoded in, e.g. windows-1252, shift_jis (default: utf-8)")
+	pflag.IntVarP(&upperBound, "max", "m", 200000, "Max value of the sum (in base 10)")
+	pflag.StringVarP(&key, "key", "k", "wanderlust", "cipher")
+	pflag.BoolVarP(&leading0, "leading0", "0", false, "Whether to start the \"numbers\" list with 0")
+	pflag.StringVarP(&outputPath, "output", "o", "", "File path to output results")
+	pflag.StringVar(&opFlag, "op", "add", "Equation search to run: add, mul, sub, div, or all")
+	pflag.StringVar(&formatFlag, "format", "", "Output format: csv, tsv, ndjson, or sqlite (default: inferred from --output, else csv)")
+	pflag.IntVar(&workers, "workers", runtime.NumCPU(), "Number of worker goroutines for the equation search")
+	pflag.IntVarP(&terms, "terms", "t", 2, fmt.Sprintf("Number of addends to sum (2-%d); values above 2 run a meet-in-the-middle N-term search and only support --op add", lingo.MaxKSumTerms))
+	pflag.Lookup("output").DefValue = "<key>-<max>[-0].csv"
+	pflag.Parse()
+
+	format := formatFlag
+	if format == "" && pflag.Lookup("output").Changed {
+		format = sink.InferFormat(outputPath)
+	}
+	if format == "" {
+		format = "csv"
+	}
+
+	if !pflag.Lookup("output").Changed {
+		suffix := ""
+		if leading0 {
+			suffix = "-0"
+		}
+
+		// Format: key-upperBound[-0].<ext>
+		outputPath = key + "-" + strconv.Itoa(upperBound) + suffix + sink.Extension(format)
+	}
+
+	if terms < 2 || terms > lingo.MaxKSumTerms {
+		fmt.Printf("error: --terms must be between 2 and %d\n", lingo.MaxKSumTerms)
+		os.Exit(1)
+	}
+	if terms > 2 && opFlag != "add" {
+		fmt.Println("error: --terms above 2 only supports --op add")
+		os.Exit(1)
+	}
+
+	var ops []lingo.Operator
+	if opFlag == "all" {
+		ops = []lingo.Operator{lingo.OpAdd, lingo.OpSub, lingo.OpMul, lingo.OpDiv}
+	} else {
+		op, err := lingo.ParseOperator(opFlag)
+		if err != nil {
+			fmt.Println("error parsing --op:", err)
+			os.Exit(1)
+		}
+		ops = []lingo.Operator{op}
+	}
+
+	wordList, err := lingo.MakeWordList(wordListPath, wordListEnc)
+	if err != nil {
+		fmt.Println("error loading word list:", err)
+		os.Exit(1)
+	}
+	cipher, err := lingo.CipherFromKey(key, leading0)
+	if err != nil {
+		fmt.Println("error creating cipher:", err)
+		os.Exit(1)
+	}
+
+	s, err := sink.New(format, outputPath)
+	if err != nil {
+		fmt.Println("error creating output sink:", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := s.Close(); err != nil {
+			fmt.Println("error closing output sink:", err)
+			os.Exit(1)
+		}
+	}()
+
+	if terms > 2 {
+		// N-term equations have a variable number of operands, so they go
+		// through WriteEquation rather than the fixed 3-column WriteHeader
+		// used below for the a op b = c case.
+		err := cipher.FindValidKSums(terms, upperBound, wordList, func(words []lingo.Word) {
+			if err := s.WriteEquation(words, lingo.OpAdd); err != nil {
+				fmt.Println("error writing equation:", err)
+				os.Exit(1)
+			}
+		})
+		if err != nil {
+			fmt.Println("error running N-term search:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := s.WriteHeader(); err != nil {
+		fmt.Println("error writing output header:", err)
+		os.Exit(1)
+	}
+
+	action := func(t lingo.Triplet, op lingo.Operator) {
+		if err := s.WriteTriplet(t, op); err != nil {
+			fmt.Println("error writing triplet:", err)
+			os.Exit(1)
+		}
+	}
+	for _, op := range ops {
+		cipher.FindValidEquations(op, upperBound, workers, wordList, action)
+	}
+}
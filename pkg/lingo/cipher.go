@@ -0,0 +1,143 @@
+// Package lingo implements the letter/number substitution cipher and word
+// equation search that power lingo-cipher, so the core logic can be reused
+// outside of the command-line tool.
+package lingo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// ErrKeyTooLong is returned when a cipher key has 36 or more characters
+// (counted in bytes).
+var ErrKeyTooLong = errors.New("cipher key too long")
+
+// ErrRepeatLetter is returned when a cipher key uses the same letter twice,
+// since each letter must map to a distinct digit.
+var ErrRepeatLetter = errors.New("cipher key contains repeat letters")
+
+// Cipher maps the letters of a key to the digits of a base equal to the
+// key's length, and back again; one of the key's letters maps to "0".
+type Cipher struct {
+	letterToNumber map[rune]rune
+	numberToLetter map[rune]rune
+	base           int
+}
+
+// CipherFromKey builds a Cipher from a key whose letters must all be
+// distinct. When leading0 is true, the key's first letter maps to "0";
+// otherwise its last letter does.
+func CipherFromKey(k string, leading0 bool) (Cipher, error) {
+	length := len(k)
+	if length >= 36 {
+		return Cipher{}, ErrKeyTooLong
+	}
+	letters := []rune{}
+	for _, l := range k {
+		if slices.Contains(letters, l) {
+			return Cipher{}, ErrRepeatLetter
+		}
+		letters = append(letters, l)
+	}
+	numbers, err := MakeNumbers(length, leading0)
+	if err != nil {
+		return Cipher{}, err
+	}
+
+	letterToNumber := make(map[rune]rune, length)
+	numberToLetter := make(map[rune]rune, length)
+	for i := range length {
+		letterToNumber[letters[i]] = numbers[i]
+		numberToLetter[numbers[i]] = letters[i]
+	}
+	return Cipher{letterToNumber, numberToLetter, length}, nil
+}
+
+// MakeNumbers returns the l base-36 digits that a key of length l is mapped
+// to: l-1 nonzero digits (as runes '1'-'9', then 'a'-'z') plus the "0"
+// digit, placed first if leading0 is set or last otherwise.
+func MakeNumbers(l int, leading0 bool) ([]rune, error) {
+	if l >= 36 {
+		return nil, ErrKeyTooLong
+	}
+	numbers := []rune{}
+	lengthWithout0 := l - 1
+	if lengthWithout0 <= 8 {
+		for i := range lengthWithout0 {
+			numbers = append(numbers, rune('0'+i+1))
+		}
+	} else {
+		for i := range 9 {
+			numbers = append(numbers, rune('0'+i+1))
+		}
+		lettersLength := lengthWithout0 - 9
+		for i := range lettersLength {
+			numbers = append(numbers, rune('a'+i))
+		}
+	}
+	if leading0 {
+		return append([]rune{rune('0')}, numbers...), nil
+	}
+	return append(numbers, rune('0')), nil
+}
+
+// FromLetters ciphers a string of key letters into its digit string.
+func (c Cipher) FromLetters(letters string) string {
+	numbers := make([]rune, 0, len(letters))
+	for _, l := range letters {
+		numbers = append(numbers, c.letterToNumber[l])
+	}
+	return string(numbers)
+}
+
+// FromNumbers deciphers a digit string back into key letters.
+func (c Cipher) FromNumbers(numbers string) string {
+	letters := make([]rune, 0, len(numbers))
+	for _, n := range numbers {
+		letters = append(letters, c.numberToLetter[n])
+	}
+	return string(letters)
+}
+
+func (c Cipher) fromInt(val int) (string, string) {
+	if c.base == 10 {
+		numbers := strconv.Itoa(val)
+		letters := c.FromNumbers(numbers)
+		return numbers, letters
+	}
+	numbers := strconv.FormatInt(int64(val), c.base)
+	letters := c.FromNumbers(numbers)
+	return numbers, letters
+}
+
+// BaseAdd adds two base-b numeral strings and returns their sum, also as a
+// base-b numeral string.
+func BaseAdd(n1, n2 string, b int) (string, error) {
+	val1, err := strconv.ParseInt(n1, b, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid base-%d string n1: %v", b, err)
+	}
+	val2, err := strconv.ParseInt(n2, b, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid base-%d string n2: %v", b, err)
+	}
+	sum := val1 + val2
+	return strconv.FormatInt(sum, b), nil
+}
+
+// BaseTimes multiplies two base-b numeral strings and returns their
+// product, also as a base-b numeral string.
+func BaseTimes(n1, n2 string, b int) (string, error) {
+	val1, err := strconv.ParseInt(n1, b, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid base-%d string n1: %v", b, err)
+	}
+	val2, err := strconv.ParseInt(n2, b, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid base-%d string n2: %v", b, err)
+	}
+	product := val1 * val2
+	return strconv.FormatInt(product, b), nil
+}
@@ -0,0 +1,53 @@
+package lingo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMakeNumbers(t *testing.T) {
+	for l := 1; l < 36; l++ {
+		for _, leading0 := range []bool{false, true} {
+			numbers, err := MakeNumbers(l, leading0)
+			if err != nil {
+				t.Fatalf("MakeNumbers(%d, %v): unexpected error: %v", l, leading0, err)
+			}
+			if len(numbers) != l {
+				t.Fatalf("MakeNumbers(%d, %v): got %d numbers, want %d", l, leading0, len(numbers), l)
+			}
+			if leading0 && numbers[0] != '0' {
+				t.Fatalf("MakeNumbers(%d, true): first digit = %q, want '0'", l, numbers[0])
+			}
+			if !leading0 && numbers[len(numbers)-1] != '0' {
+				t.Fatalf("MakeNumbers(%d, false): last digit = %q, want '0'", l, numbers[len(numbers)-1])
+			}
+		}
+	}
+}
+
+func TestMakeNumbersTooLong(t *testing.T) {
+	if _, err := MakeNumbers(36, false); !errors.Is(err, ErrKeyTooLong) {
+		t.Fatalf("MakeNumbers(36, false): got err %v, want ErrKeyTooLong", err)
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	const key = "wanderlust"
+	c, err := CipherFromKey(key, false)
+	if err != nil {
+		t.Fatalf("CipherFromKey(%q): unexpected error: %v", key, err)
+	}
+
+	for _, letters := range []string{"w", "an", "lust", "wanderlust"} {
+		numbers := c.FromLetters(letters)
+		if got := c.FromNumbers(numbers); got != letters {
+			t.Errorf("round trip for %q: got %q, want %q", letters, got, letters)
+		}
+	}
+}
+
+func TestCipherFromKeyRepeatLetter(t *testing.T) {
+	if _, err := CipherFromKey("hello", false); !errors.Is(err, ErrRepeatLetter) {
+		t.Fatalf("CipherFromKey(\"hello\"): got err %v, want ErrRepeatLetter", err)
+	}
+}
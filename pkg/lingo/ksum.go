@@ -0,0 +1,122 @@
+package lingo
+
+import "fmt"
+
+// MaxKSumTerms caps FindValidKSums: runtime and memory both scale as
+// O(N^{k/2}) in the number of valid words N, so every two additional terms
+// multiply the map FindValidKSums has to build and hold in memory by
+// roughly N.
+// Past this point the meet-in-the-middle search stops being a useful trade
+// against the brute-force O(N^k) it replaces.
+const MaxKSumTerms = 6
+
+// FindValidKSums finds every way to write a valid word as the sum of k
+// other valid words: a1 + a2 + ... + ak = s. It generalizes FindValidSums
+// (k=2) with a meet-in-the-middle search: the k addends are split into two
+// halves of size ceil(k/2) and floor(k/2), each half's same-size
+// combinations are summed and grouped into a map keyed by their total, and
+// then for every candidate total s the two maps are joined on
+// sumA + sumB == s.
+//
+// Each action call receives k+1 Words: the k addends in ascending numeric
+// order, followed by the sum s. Addends are non-decreasing within a half,
+// and a pair of half-tuples is only reported when the first half's largest
+// addend is <= the second half's smallest one. That's the canonical split
+// of the full sorted k-tuple (its first ceil(k/2) addends vs. its last
+// floor(k/2)), so each distinct multiset of addends is reported exactly
+// once no matter how the two halves' sizes compare — unlike comparing
+// sumA and sumB directly, which only happens to dedupe correctly when the
+// halves are equal-sized (even k).
+func (c Cipher) FindValidKSums(k, maxSum int, wl WordList, action func([]Word)) error {
+	if k < 2 {
+		return fmt.Errorf("FindValidKSums: k must be at least 2, got %d", k)
+	}
+	if k > MaxKSumTerms {
+		return fmt.Errorf("FindValidKSums: k capped at %d terms (O(N^%d) memory), got %d", MaxKSumTerms, MaxKSumTerms/2, k)
+	}
+
+	validInfo := make([]Word, maxSum)
+	isValid := make([]bool, maxSum)
+	validNumbers := make([]int, 0)
+	for v := range maxSum {
+		numbers, letters := c.fromInt(v)
+		if IsValidWord(letters, wl) {
+			validInfo[v] = Word{numbers, letters}
+			isValid[v] = true
+			validNumbers = append(validNumbers, v)
+		}
+	}
+
+	halfA := (k + 1) / 2
+	halfB := k / 2
+
+	sumsA := kSumCombinations(validNumbers, halfA, maxSum)
+	sumsB := sumsA
+	if halfB != halfA {
+		sumsB = kSumCombinations(validNumbers, halfB, maxSum)
+	}
+
+	for _, s := range validNumbers {
+		for sumA, tuplesA := range sumsA {
+			sumB := s - sumA
+			tuplesB, ok := sumsB[sumB]
+			if !ok {
+				continue
+			}
+
+			for _, a := range tuplesA {
+				// a and b are each built in non-decreasing order, so a's
+				// largest addend is its last element and b's smallest is
+				// its first.
+				maxA := a[len(a)-1]
+				for _, b := range tuplesB {
+					if maxA > b[0] {
+						continue
+					}
+
+					words := make([]Word, 0, k+1)
+					for _, v := range a {
+						words = append(words, validInfo[v])
+					}
+					for _, v := range b {
+						words = append(words, validInfo[v])
+					}
+					words = append(words, validInfo[s])
+					action(words)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// kSumCombinations enumerates every non-decreasing combination of size
+// values drawn, with repetition, from validNumbers whose sum is below
+// maxSum, grouped by that sum.
+func kSumCombinations(validNumbers []int, size, maxSum int) map[int][][]int {
+	sums := make(map[int][][]int)
+	if size == 0 {
+		sums[0] = [][]int{{}}
+		return sums
+	}
+
+	combo := make([]int, 0, size)
+	var recurse func(start, remaining, sum int)
+	recurse = func(start, remaining, sum int) {
+		if remaining == 0 {
+			sums[sum] = append(sums[sum], append([]int(nil), combo...))
+			return
+		}
+		for i := start; i < len(validNumbers); i++ {
+			v := validNumbers[i]
+			if sum+v >= maxSum {
+				break
+			}
+			combo = append(combo, v)
+			recurse(i, remaining-1, sum+v)
+			combo = combo[:len(combo)-1]
+		}
+	}
+	recurse(0, size, 0)
+	return sums
+}
@@ -0,0 +1,170 @@
+package lingo
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func lettersOf(words []Word) []string {
+	out := make([]string, len(words))
+	for i, w := range words {
+		out[i] = w.letters
+	}
+	return out
+}
+
+func sortStringTuples(tuples [][]string) {
+	sort.Slice(tuples, func(i, j int) bool {
+		a, b := tuples[i], tuples[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
+
+func TestFindValidKSumsRejectsBadK(t *testing.T) {
+	c, err := CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+	wl, err := MakeWordList("testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+
+	if err := c.FindValidKSums(1, 8, wl, func([]Word) {}); err == nil {
+		t.Error("FindValidKSums(1, ...): expected error for k < 2, got nil")
+	}
+	if err := c.FindValidKSums(MaxKSumTerms+1, 8, wl, func([]Word) {}); err == nil {
+		t.Errorf("FindValidKSums(%d, ...): expected error for k > MaxKSumTerms, got nil", MaxKSumTerms+1)
+	}
+}
+
+func TestFindValidKSums(t *testing.T) {
+	c, err := CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+	wl, err := MakeWordList("testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+
+	var got [][]string
+	if err := c.FindValidKSums(3, 8, wl, func(words []Word) {
+		got = append(got, lettersOf(words))
+	}); err != nil {
+		t.Fatalf("FindValidKSums(3, ...): unexpected error: %v", err)
+	}
+
+	want := [][]string{
+		{"b", "b", "b", "b"},
+		{"b", "b", "a", "a"},
+		{"b", "b", "ab", "ab"},
+		{"b", "a", "a", "ab"},
+	}
+
+	sortStringTuples(got)
+	sortStringTuples(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindValidKSums(3, ...) results = %v, want %v", got, want)
+	}
+}
+
+// bruteForceKSums independently enumerates every non-decreasing k-tuple of
+// validNumbers whose sum is also in validNumbers, by brute force rather than
+// meet-in-the-middle. It's the oracle TestFindValidKSumsOddK checks
+// FindValidKSums against, so the two halves of that test can't share a bug.
+func bruteForceKSums(k int, validNumbers []int) [][]int {
+	isValid := make(map[int]bool, len(validNumbers))
+	for _, v := range validNumbers {
+		isValid[v] = true
+	}
+
+	var results [][]int
+	combo := make([]int, 0, k)
+	var recurse func(start, remaining, sum int)
+	recurse = func(start, remaining, sum int) {
+		if remaining == 0 {
+			if isValid[sum] {
+				tuple := append(append([]int(nil), combo...), sum)
+				results = append(results, tuple)
+			}
+			return
+		}
+		for i := start; i < len(validNumbers); i++ {
+			combo = append(combo, validNumbers[i])
+			recurse(i, remaining-1, sum+validNumbers[i])
+			combo = combo[:len(combo)-1]
+		}
+	}
+	recurse(0, k, 0)
+	return results
+}
+
+// TestFindValidKSumsOddK guards against a bug where the meet-in-the-middle
+// join only deduplicated correctly when both halves were the same size
+// (even k): for odd k, requiring sumA <= sumB silently dropped genuine
+// equations instead of just dropping duplicate splits. Fibonacci-ish
+// numbers are used for validNumbers because their sums rarely coincide by
+// accident, which would mask a dedup bug either way.
+func TestFindValidKSumsOddK(t *testing.T) {
+	c, err := CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+
+	// fromInt(v) under the "ab" cipher (base 2, a=1, b=0) for each of these
+	// values, picked so none of the other numbers below maxSum collide with
+	// these letter strings.
+	lettersToValue := map[string]int{
+		"b": 0, "a": 1, "ab": 2, "aa": 3, "aba": 5, "abbb": 8, "aaba": 13,
+	}
+	wl := make(WordList, len(lettersToValue))
+	for letters := range lettersToValue {
+		wl[letters] = struct{}{}
+	}
+	validNumbers := []int{0, 1, 2, 3, 5, 8, 13}
+	const maxSum = 16
+
+	for _, k := range []int{3, 5} {
+		var got [][]int
+		if err := c.FindValidKSums(k, maxSum, wl, func(words []Word) {
+			tuple := make([]int, len(words))
+			for i, w := range words {
+				v, ok := lettersToValue[w.letters]
+				if !ok {
+					t.Fatalf("FindValidKSums(%d, ...): unexpected word %q", k, w.letters)
+				}
+				tuple[i] = v
+			}
+			got = append(got, tuple)
+		}); err != nil {
+			t.Fatalf("FindValidKSums(%d, ...): unexpected error: %v", k, err)
+		}
+
+		want := bruteForceKSums(k, validNumbers)
+
+		sortIntTuples(got)
+		sortIntTuples(want)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("FindValidKSums(%d, ...) results = %v, want %v", k, got, want)
+		}
+	}
+}
+
+func sortIntTuples(tuples [][]int) {
+	sort.Slice(tuples, func(i, j int) bool {
+		a, b := tuples[i], tuples[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
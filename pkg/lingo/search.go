@@ -0,0 +1,240 @@
+package lingo
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// Word pairs a cipher digit string with the key letters it deciphers to.
+type Word struct {
+	numbers string
+	letters string
+}
+
+// Numbers returns the digit-string form of w.
+func (w Word) Numbers() string { return w.numbers }
+
+// Letters returns the key-letter form of w.
+func (w Word) Letters() string { return w.letters }
+
+// Triplet is a found equation's three operands: (a, b, c) such that
+// a op b = c.
+type Triplet [3]Word
+
+// Operator identifies which arithmetic relation an equation search looks
+// for, and doubles as the symbol written out in output sinks.
+type Operator rune
+
+const (
+	OpAdd Operator = '+'
+	OpSub Operator = '-'
+	OpMul Operator = '×'
+	OpDiv Operator = '÷'
+)
+
+func (op Operator) String() string {
+	return string(op)
+}
+
+// ParseOperator maps an --op flag value to an Operator. "all" is handled by
+// the caller, since it expands to every operator rather than naming one.
+func ParseOperator(s string) (Operator, error) {
+	switch s {
+	case "add":
+		return OpAdd, nil
+	case "sub":
+		return OpSub, nil
+	case "mul":
+		return OpMul, nil
+	case "div":
+		return OpDiv, nil
+	default:
+		return 0, fmt.Errorf("unknown operator %q", s)
+	}
+}
+
+// FindValidSums searches for word triplets (a, b, c) with a + b = c.
+func (c Cipher) FindValidSums(maxSum, workers int, wl WordList, action func(Triplet)) {
+	c.FindValidEquations(OpAdd, maxSum, workers, wl, func(t Triplet, _ Operator) {
+		action(t)
+	})
+}
+
+// FindValidProducts searches for word triplets (a, b, c) with a × b = c.
+func (c Cipher) FindValidProducts(maxSum, workers int, wl WordList, action func(Triplet)) {
+	c.FindValidEquations(OpMul, maxSum, workers, wl, func(t Triplet, _ Operator) {
+		action(t)
+	})
+}
+
+// FindValidEquations searches for word triplets (a, b, c) where every number
+// below maxSum is a candidate and c is the result of a op b. For the
+// commutative operators (+, x) pairs are deduplicated by only considering
+// b >= a; for - and / every ordered pair with a > b > 0 is tried since the
+// result depends on order. For + both a and b are kept below maxSum/2, so
+// sums whose larger addend is maxSum/2 or more are not reported.
+//
+// The outer loop is sharded across workers goroutines (runtime.NumCPU() if
+// workers <= 0); each shard accumulates its own slice of matches, and the
+// shards are replayed through action in outer-loop order once every
+// goroutine finishes, so output order is unaffected by scheduling.
+func (c Cipher) FindValidEquations(op Operator, maxSum, workers int, wl WordList, action func(Triplet, Operator)) {
+	validInfo := make([]Word, maxSum)
+	isValid := make([]bool, maxSum)
+	validNumbers := make([]int, 0)
+
+	for k := range maxSum {
+		numbers, letters := c.fromInt(k)
+		if IsValidWord(letters, wl) {
+			validInfo[k] = Word{numbers, letters}
+			isValid[k] = true
+			validNumbers = append(validNumbers, k)
+		}
+	}
+
+	outer := outerRange(op, maxSum, validNumbers)
+	if len(outer) == 0 {
+		return
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(outer) {
+		workers = len(outer)
+	}
+
+	type shard struct {
+		id      int
+		results []Triplet
+	}
+	shardCh := make(chan shard, workers)
+
+	shardSize := (len(outer) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for w := range workers {
+		start := w * shardSize
+		end := min(start+shardSize, len(outer))
+		if start >= end {
+			continue
+		}
+
+		wg.Add(1)
+		go func(id, start, end int) {
+			defer wg.Done()
+			var results []Triplet
+			for _, i := range outer[start:end] {
+				results = append(results, matches(c, op, i, maxSum, validNumbers, validInfo, isValid)...)
+			}
+			shardCh <- shard{id, results}
+		}(w, start, end)
+	}
+
+	go func() {
+		wg.Wait()
+		close(shardCh)
+	}()
+
+	ordered := make([][]Triplet, workers)
+	for s := range shardCh {
+		ordered[s.id] = s.results
+	}
+
+	for _, results := range ordered {
+		for _, t := range results {
+			action(t, op)
+		}
+	}
+}
+
+// outerRange returns the values of the outer search loop's index i, in
+// ascending order, for a given operator. Add and multiply only need i below
+// the midpoint since b >= i covers the rest; subtract and divide need every
+// candidate since b must be strictly less than i.
+func outerRange(op Operator, maxSum int, validNumbers []int) []int {
+	if op != OpAdd && op != OpMul {
+		return validNumbers
+	}
+	maxNumber := maxSum / 2
+	outer := make([]int, 0, len(validNumbers))
+	for _, i := range validNumbers {
+		if i >= maxNumber {
+			break
+		}
+		outer = append(outer, i)
+	}
+	return outer
+}
+
+// matches computes every triplet produced by a single outer-loop value i, so
+// that it can be called independently from each worker's shard.
+func matches(c Cipher, op Operator, i, maxSum int, validNumbers []int, validInfo []Word, isValid []bool) []Triplet {
+	var results []Triplet
+	iWord := validInfo[i]
+
+	switch op {
+	case OpAdd, OpMul:
+		// Add keeps j, like i, below maxSum/2. Multiply's bound is
+		// i*j<maxSum, i.e. j<ceil(maxSum/i); that's a much looser cutoff
+		// than maxSum/2 for small i, and there's no cutoff at all for i=0
+		// (0*j is always 0, regardless of j).
+		limit := maxSum / 2
+		if op == OpMul {
+			switch i {
+			case 0:
+				limit = maxSum
+			default:
+				limit = (maxSum + i - 1) / i
+			}
+		}
+		for _, j := range validNumbers {
+			if j < i {
+				continue
+			}
+			if j >= limit {
+				break
+			}
+
+			var resultStr string
+			var err error
+			if op == OpAdd {
+				resultStr, err = BaseAdd(iWord.numbers, validInfo[j].numbers, c.base)
+			} else {
+				resultStr, err = BaseTimes(iWord.numbers, validInfo[j].numbers, c.base)
+			}
+			if err != nil {
+				continue
+			}
+			result64, err := strconv.ParseInt(resultStr, c.base, 64)
+			if err != nil {
+				continue
+			}
+			result := int(result64)
+			if result < maxSum && isValid[result] {
+				results = append(results, Triplet{iWord, validInfo[j], validInfo[result]})
+			}
+		}
+	case OpSub, OpDiv:
+		for _, j := range validNumbers {
+			if j == 0 || j >= i {
+				continue
+			}
+
+			var result int
+			if op == OpSub {
+				result = i - j
+			} else {
+				if i%j != 0 {
+					continue
+				}
+				result = i / j
+			}
+			if isValid[result] {
+				results = append(results, Triplet{iWord, validInfo[j], validInfo[result]})
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,175 @@
+package lingo
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestFindValidSums(t *testing.T) {
+	wl, err := MakeWordList("testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+	c, err := CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+
+	var got [][3]string
+	c.FindValidSums(4, 1, wl, func(tr Triplet) {
+		got = append(got, [3]string{tr[0].letters, tr[1].letters, tr[2].letters})
+	})
+
+	want := [][3]string{
+		{"b", "b", "b"},
+		{"b", "a", "a"},
+		{"a", "a", "ab"},
+	}
+
+	less := func(s [][3]string) func(i, j int) bool {
+		return func(i, j int) bool {
+			a, b := s[i], s[j]
+			for k := range a {
+				if a[k] != b[k] {
+					return a[k] < b[k]
+				}
+			}
+			return false
+		}
+	}
+	sort.Slice(got, less(got))
+	sort.Slice(want, less(want))
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindValidSums triplets = %v, want %v", got, want)
+	}
+}
+
+func wordList(words ...string) WordList {
+	wl := make(WordList, len(words))
+	for _, w := range words {
+		wl[w] = struct{}{}
+	}
+	return wl
+}
+
+// TestFindValidEquations covers every operator other than add, which
+// TestFindValidSums already exercises: mul (commutative, like add), and sub
+// and div (order-sensitive, with their own zero-divisor and j < i rules).
+// Each case uses a small key-"ab" wordlist hand-picked so the expected
+// triplets can be verified by inspection.
+func TestFindValidEquations(t *testing.T) {
+	c, err := CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		op   Operator
+		wl   WordList
+		want [][3]string
+	}{
+		{
+			name: "mul",
+			op:   OpMul,
+			wl:   wordList("ab", "aa", "aab"),
+			want: [][3]string{
+				{"ab", "aa", "aab"},
+			},
+		},
+		{
+			name: "sub",
+			op:   OpSub,
+			wl:   wordList("a", "ab", "aa"),
+			want: [][3]string{
+				{"ab", "a", "a"},
+				{"aa", "a", "ab"},
+				{"aa", "ab", "a"},
+			},
+		},
+		{
+			name: "div",
+			op:   OpDiv,
+			wl:   wordList("a", "ab", "abb"),
+			want: [][3]string{
+				{"ab", "a", "ab"},
+				{"abb", "a", "abb"},
+				{"abb", "ab", "ab"},
+			},
+		},
+	}
+
+	less := func(s [][3]string) func(i, j int) bool {
+		return func(i, j int) bool {
+			a, b := s[i], s[j]
+			for k := range a {
+				if a[k] != b[k] {
+					return a[k] < b[k]
+				}
+			}
+			return false
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][3]string
+			c.FindValidEquations(tt.op, 8, 1, tt.wl, func(tr Triplet, op Operator) {
+				if op != tt.op {
+					t.Errorf("action called with op %v, want %v", op, tt.op)
+				}
+				got = append(got, [3]string{tr[0].letters, tr[1].letters, tr[2].letters})
+			})
+
+			sort.Slice(got, less(got))
+			sort.Slice(tt.want, less(tt.want))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("FindValidEquations(%v, ...) triplets = %v, want %v", tt.op, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFindValidEquationsParallel guards against the outer-loop sharding
+// producing different results than a single worker, since each shard's
+// matches are computed independently before being replayed in order.
+func TestFindValidEquationsParallel(t *testing.T) {
+	wl, err := MakeWordList("testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+	c, err := CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+
+	var want [][3]string
+	c.FindValidSums(4, 1, wl, func(tr Triplet) {
+		want = append(want, [3]string{tr[0].letters, tr[1].letters, tr[2].letters})
+	})
+
+	var got [][3]string
+	c.FindValidSums(4, 4, wl, func(tr Triplet) {
+		got = append(got, [3]string{tr[0].letters, tr[1].letters, tr[2].letters})
+	})
+
+	less := func(s [][3]string) func(i, j int) bool {
+		return func(i, j int) bool {
+			a, b := s[i], s[j]
+			for k := range a {
+				if a[k] != b[k] {
+					return a[k] < b[k]
+				}
+			}
+			return false
+		}
+	}
+	sort.Slice(got, less(got))
+	sort.Slice(want, less(want))
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindValidSums(workers=4) triplets = %v, want (workers=1) %v", got, want)
+	}
+}
@@ -0,0 +1,74 @@
+package lingo
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// WordList is a set of lowercase words usable as equation operands.
+type WordList map[string]struct{}
+
+// MakeWordList streams a newline-separated word list from fileName,
+// trimming whitespace and lowercasing each entry. encodingName selects the
+// charset the file is written in (e.g. "windows-1252", "shift_jis"); an
+// empty string or "utf-8" reads the file as-is.
+func MakeWordList(fileName, encodingName string) (WordList, error) {
+	wordList := make(WordList)
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return wordList, err
+	}
+	defer file.Close()
+
+	enc, err := lookupEncoding(encodingName)
+	if err != nil {
+		return wordList, err
+	}
+
+	scanner := bufio.NewScanner(transform.NewReader(file, enc.NewDecoder()))
+	for scanner.Scan() {
+		word := strings.TrimSpace(strings.ToLower(scanner.Text()))
+		if word != "" {
+			wordList[word] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return wordList, err
+	}
+	return wordList, nil
+}
+
+// lookupEncoding resolves a --word-list-encoding value to an
+// encoding.Encoding. It maps a handful of common names and aliases itself
+// ("latin1" to Windows-1252) before falling back to an IANA name lookup.
+func lookupEncoding(name string) (encoding.Encoding, error) {
+	switch strings.ToLower(name) {
+	case "", "utf-8", "utf8":
+		return encoding.Nop, nil
+	case "windows-1252", "cp1252", "latin1":
+		return charmap.Windows1252, nil
+	case "shift-jis", "shiftjis", "sjis":
+		return japanese.ShiftJIS, nil
+	}
+
+	enc, err := ianaindex.IANA.Encoding(name)
+	if err != nil || enc == nil {
+		return nil, fmt.Errorf("unknown word list encoding %q", name)
+	}
+	return enc, nil
+}
+
+// IsValidWord reports whether w is a member of wl.
+func IsValidWord(w string, wl WordList) bool {
+	_, ok := wl[w]
+	return ok
+}
@@ -0,0 +1,101 @@
+package lingo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// writeEncodedWordList encodes words (one per line) with enc and writes the
+// result to a temp file, so tests can feed MakeWordList real non-UTF-8 bytes
+// instead of asserting against hand-written fixtures.
+func writeEncodedWordList(t *testing.T, enc encoding.Encoding, words ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "words.txt")
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating %s: %v", path, err)
+	}
+	defer f.Close()
+
+	w := transform.NewWriter(f, enc.NewEncoder())
+	for _, word := range words {
+		if _, err := w.Write([]byte(word + "\n")); err != nil {
+			t.Fatalf("encoding word list: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("closing encoded writer: %v", err)
+	}
+	return path
+}
+
+func TestMakeWordListUTF8(t *testing.T) {
+	wl, err := MakeWordList("testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+	for _, word := range []string{"a", "b", "ab"} {
+		if !IsValidWord(word, wl) {
+			t.Errorf("MakeWordList(..., \"\"): %q not found in word list", word)
+		}
+	}
+}
+
+func TestMakeWordListWindows1252(t *testing.T) {
+	// é is 0xE9 in Windows-1252 but a multi-byte sequence in UTF-8, so a
+	// naive byte-for-byte read would mangle it into mojibake.
+	path := writeEncodedWordList(t, charmap.Windows1252, "café")
+
+	for _, alias := range []string{"windows-1252", "cp1252", "latin1", "Windows-1252"} {
+		wl, err := MakeWordList(path, alias)
+		if err != nil {
+			t.Fatalf("MakeWordList(..., %q): unexpected error: %v", alias, err)
+		}
+		if !IsValidWord("café", wl) {
+			t.Errorf("MakeWordList(..., %q): \"café\" not found in word list %v", alias, wl)
+		}
+	}
+}
+
+func TestMakeWordListShiftJIS(t *testing.T) {
+	path := writeEncodedWordList(t, japanese.ShiftJIS, "ねこ")
+
+	for _, alias := range []string{"shift-jis", "shiftjis", "sjis"} {
+		wl, err := MakeWordList(path, alias)
+		if err != nil {
+			t.Fatalf("MakeWordList(..., %q): unexpected error: %v", alias, err)
+		}
+		if !IsValidWord("ねこ", wl) {
+			t.Errorf("MakeWordList(..., %q): \"ねこ\" not found in word list %v", alias, wl)
+		}
+	}
+}
+
+// TestMakeWordListIANAFallback covers an encoding name lookupEncoding
+// doesn't special-case, to exercise the ianaindex fallback path.
+func TestMakeWordListIANAFallback(t *testing.T) {
+	path := writeEncodedWordList(t, charmap.ISO8859_1, "größe")
+
+	wl, err := MakeWordList(path, "ISO-8859-1")
+	if err != nil {
+		t.Fatalf("MakeWordList(..., \"ISO-8859-1\"): unexpected error: %v", err)
+	}
+	if !IsValidWord("größe", wl) {
+		t.Errorf("MakeWordList(..., \"ISO-8859-1\"): \"größe\" not found in word list %v", wl)
+	}
+}
+
+func TestMakeWordListUnknownEncoding(t *testing.T) {
+	path := writeEncodedWordList(t, encoding.Nop, "a")
+
+	if _, err := MakeWordList(path, "not-a-real-charset"); err == nil {
+		t.Error("MakeWordList(..., \"not-a-real-charset\"): expected error, got nil")
+	}
+}
@@ -0,0 +1,64 @@
+package sink
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+// delimitedSink writes triplets as delimiter-separated records; with ','
+// it's CSV, with '\t' it's TSV.
+type delimitedSink struct {
+	file *os.File
+	w    *csv.Writer
+}
+
+func newDelimitedSink(path string, comma rune) (Sink, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w := csv.NewWriter(file)
+	w.Comma = comma
+	return &delimitedSink{file: file, w: w}, nil
+}
+
+func (s *delimitedSink) WriteHeader() error {
+	return s.w.Write([]string{"Numbers 1", "Letters 1", "Numbers 2", "Letters 2", "Numbers 3", "Letters 3", "Operator"})
+}
+
+func (s *delimitedSink) WriteTriplet(t lingo.Triplet, op lingo.Operator) error {
+	return s.w.Write([]string{
+		t[0].Numbers(), t[0].Letters(),
+		t[1].Numbers(), t[1].Letters(),
+		t[2].Numbers(), t[2].Letters(),
+		op.String(),
+	})
+}
+
+// WriteEquation writes one record per call, with a Numbers/Letters column
+// pair per word followed by the operator. Its column count therefore grows
+// with len(words), so it won't match the fixed 7-column header WriteHeader
+// writes for 3-operand equations — callers that mix arities in one output
+// should expect that.
+func (s *delimitedSink) WriteEquation(words []lingo.Word, op lingo.Operator) error {
+	if len(words) < 2 {
+		return fmt.Errorf("WriteEquation: need at least 2 words (addends + sum), got %d", len(words))
+	}
+	record := make([]string, 0, 2*len(words)+1)
+	for _, w := range words {
+		record = append(record, w.Numbers(), w.Letters())
+	}
+	record = append(record, op.String())
+	return s.w.Write(record)
+}
+
+func (s *delimitedSink) Close() error {
+	s.w.Flush()
+	if err := s.w.Error(); err != nil {
+		return err
+	}
+	return s.file.Close()
+}
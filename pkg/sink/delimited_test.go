@@ -0,0 +1,128 @@
+package sink
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+func readDelimited(t *testing.T, path string, comma rune) [][]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening %s: %v", path, err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = comma
+	r.FieldsPerRecord = -1 // WriteEquation's column count varies with arity
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	return records
+}
+
+func TestDelimitedSinkCSV(t *testing.T) {
+	triplet := testTriplet(t)
+	words := testEquationWords(t)
+	path := filepath.Join(t.TempDir(), "out.csv")
+
+	s, err := newDelimitedSink(path, ',')
+	if err != nil {
+		t.Fatalf("newDelimitedSink: unexpected error: %v", err)
+	}
+	if err := s.WriteHeader(); err != nil {
+		t.Fatalf("WriteHeader: unexpected error: %v", err)
+	}
+	if err := s.WriteTriplet(triplet, lingo.OpAdd); err != nil {
+		t.Fatalf("WriteTriplet: unexpected error: %v", err)
+	}
+	if err := s.WriteEquation(words, lingo.OpAdd); err != nil {
+		t.Fatalf("WriteEquation: unexpected error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+
+	records := readDelimited(t, path, ',')
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3 (header, triplet, equation)", len(records))
+	}
+
+	wantHeader := []string{"Numbers 1", "Letters 1", "Numbers 2", "Letters 2", "Numbers 3", "Letters 3", "Operator"}
+	if got := records[0]; len(got) != len(wantHeader) {
+		t.Errorf("header = %v, want %v", got, wantHeader)
+	}
+
+	wantTriplet := []string{
+		triplet[0].Numbers(), triplet[0].Letters(),
+		triplet[1].Numbers(), triplet[1].Letters(),
+		triplet[2].Numbers(), triplet[2].Letters(),
+		lingo.OpAdd.String(),
+	}
+	if got := records[1]; !equalStrings(got, wantTriplet) {
+		t.Errorf("triplet record = %v, want %v", got, wantTriplet)
+	}
+
+	wantEquation := make([]string, 0, 2*len(words)+1)
+	for _, w := range words {
+		wantEquation = append(wantEquation, w.Numbers(), w.Letters())
+	}
+	wantEquation = append(wantEquation, lingo.OpAdd.String())
+	if got := records[2]; !equalStrings(got, wantEquation) {
+		t.Errorf("equation record = %v, want %v", got, wantEquation)
+	}
+}
+
+func TestDelimitedSinkTSV(t *testing.T) {
+	triplet := testTriplet(t)
+	path := filepath.Join(t.TempDir(), "out.tsv")
+
+	s, err := newDelimitedSink(path, '\t')
+	if err != nil {
+		t.Fatalf("newDelimitedSink: unexpected error: %v", err)
+	}
+	if err := s.WriteTriplet(triplet, lingo.OpMul); err != nil {
+		t.Fatalf("WriteTriplet: unexpected error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+
+	records := readDelimited(t, path, '\t')
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	if got, want := records[0][len(records[0])-1], lingo.OpMul.String(); got != want {
+		t.Errorf("operator column = %q, want %q", got, want)
+	}
+}
+
+func TestDelimitedSinkWriteEquationTooFewWords(t *testing.T) {
+	s, err := newDelimitedSink(filepath.Join(t.TempDir(), "out.csv"), ',')
+	if err != nil {
+		t.Fatalf("newDelimitedSink: unexpected error: %v", err)
+	}
+	defer s.Close()
+
+	if err := s.WriteEquation([]lingo.Word{}, lingo.OpAdd); err == nil {
+		t.Error("WriteEquation(nil words): expected error, got nil")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
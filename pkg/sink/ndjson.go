@@ -0,0 +1,77 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+type ndjsonSink struct {
+	file *os.File
+	enc  *json.Encoder
+}
+
+type ndjsonOperand struct {
+	N string `json:"n"`
+	L string `json:"l"`
+}
+
+type ndjsonRecord struct {
+	A  ndjsonOperand `json:"a"`
+	B  ndjsonOperand `json:"b"`
+	C  ndjsonOperand `json:"c"`
+	Op string        `json:"op"`
+}
+
+type ndjsonEquation struct {
+	Addends []ndjsonOperand `json:"addends"`
+	Sum     ndjsonOperand   `json:"sum"`
+	Op      string          `json:"op"`
+}
+
+func newNDJSONSink(path string) (Sink, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ndjsonSink{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// WriteHeader is a no-op: NDJSON has no header record.
+func (s *ndjsonSink) WriteHeader() error {
+	return nil
+}
+
+func (s *ndjsonSink) WriteTriplet(t lingo.Triplet, op lingo.Operator) error {
+	return s.enc.Encode(ndjsonRecord{
+		A:  ndjsonOperand{N: t[0].Numbers(), L: t[0].Letters()},
+		B:  ndjsonOperand{N: t[1].Numbers(), L: t[1].Letters()},
+		C:  ndjsonOperand{N: t[2].Numbers(), L: t[2].Letters()},
+		Op: op.String(),
+	})
+}
+
+// WriteEquation writes {"addends":[{"n":..,"l":..}, ...], "sum":{...},
+// "op":".."}, where addends holds every word but the last and sum is the
+// last.
+func (s *ndjsonSink) WriteEquation(words []lingo.Word, op lingo.Operator) error {
+	if len(words) < 2 {
+		return fmt.Errorf("WriteEquation: need at least 2 words (addends + sum), got %d", len(words))
+	}
+	addends := make([]ndjsonOperand, len(words)-1)
+	for i, w := range words[:len(words)-1] {
+		addends[i] = ndjsonOperand{N: w.Numbers(), L: w.Letters()}
+	}
+	sum := words[len(words)-1]
+	return s.enc.Encode(ndjsonEquation{
+		Addends: addends,
+		Sum:     ndjsonOperand{N: sum.Numbers(), L: sum.Letters()},
+		Op:      op.String(),
+	})
+}
+
+func (s *ndjsonSink) Close() error {
+	return s.file.Close()
+}
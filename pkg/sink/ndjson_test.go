@@ -0,0 +1,96 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+func readNDJSONLines(t *testing.T, path string) []map[string]any {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening %s: %v", path, err)
+	}
+	defer f.Close()
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("unmarshalling line %q: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	return lines
+}
+
+func TestNDJSONSink(t *testing.T) {
+	triplet := testTriplet(t)
+	words := testEquationWords(t)
+	path := filepath.Join(t.TempDir(), "out.ndjson")
+
+	s, err := newNDJSONSink(path)
+	if err != nil {
+		t.Fatalf("newNDJSONSink: unexpected error: %v", err)
+	}
+	if err := s.WriteHeader(); err != nil {
+		t.Fatalf("WriteHeader: unexpected error: %v", err)
+	}
+	if err := s.WriteTriplet(triplet, lingo.OpSub); err != nil {
+		t.Fatalf("WriteTriplet: unexpected error: %v", err)
+	}
+	if err := s.WriteEquation(words, lingo.OpAdd); err != nil {
+		t.Fatalf("WriteEquation: unexpected error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+
+	lines := readNDJSONLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2 (WriteHeader is a no-op)", len(lines))
+	}
+
+	record := lines[0]
+	if record["op"] != lingo.OpSub.String() {
+		t.Errorf("triplet line op = %v, want %q", record["op"], lingo.OpSub.String())
+	}
+	a, ok := record["a"].(map[string]any)
+	if !ok || a["l"] != triplet[0].Letters() {
+		t.Errorf("triplet line a = %v, want letters %q", record["a"], triplet[0].Letters())
+	}
+
+	equation := lines[1]
+	if equation["op"] != lingo.OpAdd.String() {
+		t.Errorf("equation line op = %v, want %q", equation["op"], lingo.OpAdd.String())
+	}
+	addends, ok := equation["addends"].([]any)
+	if !ok || len(addends) != len(words)-1 {
+		t.Errorf("equation line addends = %v, want %d entries", equation["addends"], len(words)-1)
+	}
+	sum, ok := equation["sum"].(map[string]any)
+	if !ok || sum["l"] != words[len(words)-1].Letters() {
+		t.Errorf("equation line sum = %v, want letters %q", equation["sum"], words[len(words)-1].Letters())
+	}
+}
+
+func TestNDJSONSinkWriteEquationTooFewWords(t *testing.T) {
+	s, err := newNDJSONSink(filepath.Join(t.TempDir(), "out.ndjson"))
+	if err != nil {
+		t.Fatalf("newNDJSONSink: unexpected error: %v", err)
+	}
+	defer s.Close()
+
+	if err := s.WriteEquation([]lingo.Word{}, lingo.OpAdd); err == nil {
+		t.Error("WriteEquation(nil words): expected error, got nil")
+	}
+}
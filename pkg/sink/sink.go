@@ -0,0 +1,75 @@
+// Package sink writes equation search results out to a chosen output
+// format, so callers can pick whatever downstream tooling (grep, jq, SQL
+// joins) suits them without post-processing CSV.
+package sink
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+// Sink receives a header and a stream of found equations, and flushes them
+// to an underlying output.
+type Sink interface {
+	WriteHeader() error
+	// WriteTriplet writes a 3-operand equation (a op b = c), as found by
+	// FindValidEquations.
+	WriteTriplet(lingo.Triplet, lingo.Operator) error
+	// WriteEquation writes an arbitrary-arity equation, as found by
+	// FindValidKSums: words holds the addends in ascending order followed
+	// by their sum, so len(words) is k+1 and must be at least 2.
+	WriteEquation(words []lingo.Word, op lingo.Operator) error
+	Close() error
+}
+
+// New opens a Sink for path in the given format ("csv", "tsv", "ndjson", or
+// "sqlite").
+func New(format, path string) (Sink, error) {
+	switch format {
+	case "csv":
+		return newDelimitedSink(path, ',')
+	case "tsv":
+		return newDelimitedSink(path, '\t')
+	case "ndjson":
+		return newNDJSONSink(path)
+	case "sqlite":
+		return newSQLiteSink(path)
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// InferFormat guesses a format from path's extension, returning "" if the
+// extension isn't recognized.
+func InferFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".tsv":
+		return "tsv"
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	case ".sqlite", ".db":
+		return "sqlite"
+	default:
+		return ""
+	}
+}
+
+// Extension returns the default file extension for a format, for use when
+// deriving an output path that wasn't given explicitly.
+func Extension(format string) string {
+	switch format {
+	case "tsv":
+		return ".tsv"
+	case "ndjson":
+		return ".ndjson"
+	case "sqlite":
+		return ".sqlite"
+	default:
+		return ".csv"
+	}
+}
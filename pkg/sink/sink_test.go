@@ -0,0 +1,130 @@
+package sink
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+// testTriplet returns a real Triplet from FindValidSums, so sink tests
+// exercise the same Word values a caller would actually see.
+func testTriplet(t *testing.T) lingo.Triplet {
+	t.Helper()
+	c, err := lingo.CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+	wl, err := lingo.MakeWordList("../lingo/testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+
+	var triplet lingo.Triplet
+	var found bool
+	c.FindValidSums(4, 1, wl, func(tr lingo.Triplet) {
+		if !found {
+			triplet = tr
+			found = true
+		}
+	})
+	if !found {
+		t.Fatal("FindValidSums(4, ...): no triplets found to build a fixture from")
+	}
+	return triplet
+}
+
+// testEquationWords returns a real []Word from FindValidKSums (k=3), for
+// exercising WriteEquation.
+func testEquationWords(t *testing.T) []lingo.Word {
+	t.Helper()
+	c, err := lingo.CipherFromKey("ab", false)
+	if err != nil {
+		t.Fatalf("CipherFromKey: unexpected error: %v", err)
+	}
+	wl, err := lingo.MakeWordList("../lingo/testdata/words.txt", "")
+	if err != nil {
+		t.Fatalf("MakeWordList: unexpected error: %v", err)
+	}
+
+	var words []lingo.Word
+	if err := c.FindValidKSums(3, 8, wl, func(ws []lingo.Word) {
+		if words == nil {
+			words = ws
+		}
+	}); err != nil {
+		t.Fatalf("FindValidKSums(3, ...): unexpected error: %v", err)
+	}
+	if words == nil {
+		t.Fatal("FindValidKSums(3, ...): no equations found to build a fixture from")
+	}
+	return words
+}
+
+func TestInferFormat(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"out.csv", "csv"},
+		{"out.CSV", "csv"},
+		{"out.tsv", "tsv"},
+		{"out.ndjson", "ndjson"},
+		{"out.jsonl", "ndjson"},
+		{"out.sqlite", "sqlite"},
+		{"out.db", "sqlite"},
+		{"out.txt", ""},
+		{"out", ""},
+	}
+	for _, tt := range tests {
+		if got := InferFormat(tt.path); got != tt.want {
+			t.Errorf("InferFormat(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestExtension(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"csv", ".csv"},
+		{"tsv", ".tsv"},
+		{"ndjson", ".ndjson"},
+		{"sqlite", ".sqlite"},
+		{"unknown", ".csv"},
+	}
+	for _, tt := range tests {
+		if got := Extension(tt.format); got != tt.want {
+			t.Errorf("Extension(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+// TestNewRoundTrip checks that every format Extension/InferFormat knows
+// about also opens via New, so the three stay in sync.
+func TestNewRoundTrip(t *testing.T) {
+	for _, format := range []string{"csv", "tsv", "ndjson", "sqlite"} {
+		path := filepath.Join(t.TempDir(), "out"+Extension(format))
+		if got := InferFormat(path); got != format {
+			t.Fatalf("InferFormat(%q) = %q, want %q", path, got, format)
+		}
+
+		s, err := New(format, path)
+		if err != nil {
+			t.Fatalf("New(%q, ...): unexpected error: %v", format, err)
+		}
+		if err := s.WriteHeader(); err != nil {
+			t.Fatalf("New(%q, ...).WriteHeader(): unexpected error: %v", format, err)
+		}
+		if err := s.Close(); err != nil {
+			t.Fatalf("New(%q, ...).Close(): unexpected error: %v", format, err)
+		}
+	}
+}
+
+func TestNewUnknownFormat(t *testing.T) {
+	if _, err := New("xml", filepath.Join(t.TempDir(), "out.xml")); err == nil {
+		t.Error("New(\"xml\", ...): expected error, got nil")
+	}
+}
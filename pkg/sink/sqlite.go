@@ -0,0 +1,121 @@
+package sink
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+// sqliteSink writes one row per triplet into a "triplets" table, and one row
+// per arbitrary-arity equation into an "equations" table, using
+// modernc.org/sqlite so the binary stays CGo-free.
+type sqliteSink struct {
+	db     *sql.DB
+	stmt   *sql.Stmt
+	eqStmt *sql.Stmt
+}
+
+func newSQLiteSink(path string) (Sink, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS triplets (
+		a_numbers TEXT NOT NULL,
+		a_letters TEXT NOT NULL,
+		b_numbers TEXT NOT NULL,
+		b_letters TEXT NOT NULL,
+		c_numbers TEXT NOT NULL,
+		c_letters TEXT NOT NULL,
+		op        TEXT NOT NULL
+	)`); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	stmt, err := db.Prepare(`INSERT INTO triplets
+		(a_numbers, a_letters, b_numbers, b_letters, c_numbers, c_letters, op)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// "equations" holds results from FindValidKSums, whose arity varies with
+	// k; addends is stored as a JSON array rather than as fixed columns.
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS equations (
+		addends     TEXT NOT NULL,
+		sum_numbers TEXT NOT NULL,
+		sum_letters TEXT NOT NULL,
+		op          TEXT NOT NULL
+	)`); err != nil {
+		stmt.Close()
+		db.Close()
+		return nil, err
+	}
+
+	eqStmt, err := db.Prepare(`INSERT INTO equations
+		(addends, sum_numbers, sum_letters, op)
+		VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		stmt.Close()
+		db.Close()
+		return nil, err
+	}
+
+	return &sqliteSink{db: db, stmt: stmt, eqStmt: eqStmt}, nil
+}
+
+// WriteHeader is a no-op: the tables' schemas are their header.
+func (s *sqliteSink) WriteHeader() error {
+	return nil
+}
+
+func (s *sqliteSink) WriteTriplet(t lingo.Triplet, op lingo.Operator) error {
+	_, err := s.stmt.Exec(t[0].Numbers(), t[0].Letters(), t[1].Numbers(), t[1].Letters(), t[2].Numbers(), t[2].Letters(), op.String())
+	return err
+}
+
+type sqliteOperand struct {
+	N string `json:"n"`
+	L string `json:"l"`
+}
+
+// WriteEquation stores the addends (every word but the last) as a JSON
+// array, since their count varies with k, alongside the sum and operator as
+// plain columns.
+func (s *sqliteSink) WriteEquation(words []lingo.Word, op lingo.Operator) error {
+	if len(words) < 2 {
+		return fmt.Errorf("WriteEquation: need at least 2 words (addends + sum), got %d", len(words))
+	}
+	addends := make([]sqliteOperand, len(words)-1)
+	for i, w := range words[:len(words)-1] {
+		addends[i] = sqliteOperand{N: w.Numbers(), L: w.Letters()}
+	}
+	addendsJSON, err := json.Marshal(addends)
+	if err != nil {
+		return err
+	}
+
+	sum := words[len(words)-1]
+	_, err = s.eqStmt.Exec(string(addendsJSON), sum.Numbers(), sum.Letters(), op.String())
+	return err
+}
+
+func (s *sqliteSink) Close() error {
+	if err := s.stmt.Close(); err != nil {
+		s.eqStmt.Close()
+		s.db.Close()
+		return err
+	}
+	if err := s.eqStmt.Close(); err != nil {
+		s.db.Close()
+		return err
+	}
+	return s.db.Close()
+}
@@ -0,0 +1,80 @@
+package sink
+
+import (
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/Daniel-42-z/lingo-cipher/pkg/lingo"
+)
+
+func TestSQLiteSink(t *testing.T) {
+	triplet := testTriplet(t)
+	words := testEquationWords(t)
+	path := filepath.Join(t.TempDir(), "out.sqlite")
+
+	s, err := newSQLiteSink(path)
+	if err != nil {
+		t.Fatalf("newSQLiteSink: unexpected error: %v", err)
+	}
+	if err := s.WriteHeader(); err != nil {
+		t.Fatalf("WriteHeader: unexpected error: %v", err)
+	}
+	if err := s.WriteTriplet(triplet, lingo.OpDiv); err != nil {
+		t.Fatalf("WriteTriplet: unexpected error: %v", err)
+	}
+	if err := s.WriteEquation(words, lingo.OpAdd); err != nil {
+		t.Fatalf("WriteEquation: unexpected error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("sql.Open: unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	var aLetters, bLetters, cLetters, op string
+	row := db.QueryRow(`SELECT a_letters, b_letters, c_letters, op FROM triplets`)
+	if err := row.Scan(&aLetters, &bLetters, &cLetters, &op); err != nil {
+		t.Fatalf("querying triplets: %v", err)
+	}
+	if aLetters != triplet[0].Letters() || bLetters != triplet[1].Letters() || cLetters != triplet[2].Letters() || op != lingo.OpDiv.String() {
+		t.Errorf("triplets row = (%q, %q, %q, %q), want (%q, %q, %q, %q)",
+			aLetters, bLetters, cLetters, op,
+			triplet[0].Letters(), triplet[1].Letters(), triplet[2].Letters(), lingo.OpDiv.String())
+	}
+
+	var addendsJSON, sumLetters, eqOp string
+	row = db.QueryRow(`SELECT addends, sum_letters, op FROM equations`)
+	if err := row.Scan(&addendsJSON, &sumLetters, &eqOp); err != nil {
+		t.Fatalf("querying equations: %v", err)
+	}
+	var addends []sqliteOperand
+	if err := json.Unmarshal([]byte(addendsJSON), &addends); err != nil {
+		t.Fatalf("unmarshalling addends %q: %v", addendsJSON, err)
+	}
+	if len(addends) != len(words)-1 {
+		t.Errorf("got %d addends, want %d", len(addends), len(words)-1)
+	}
+	if sumLetters != words[len(words)-1].Letters() || eqOp != lingo.OpAdd.String() {
+		t.Errorf("equations row sum/op = (%q, %q), want (%q, %q)", sumLetters, eqOp, words[len(words)-1].Letters(), lingo.OpAdd.String())
+	}
+}
+
+func TestSQLiteSinkWriteEquationTooFewWords(t *testing.T) {
+	s, err := newSQLiteSink(filepath.Join(t.TempDir(), "out.sqlite"))
+	if err != nil {
+		t.Fatalf("newSQLiteSink: unexpected error: %v", err)
+	}
+	defer s.Close()
+
+	if err := s.WriteEquation([]lingo.Word{}, lingo.OpAdd); err == nil {
+		t.Error("WriteEquation(nil words): expected error, got nil")
+	}
+}